@@ -1,3 +1,5 @@
 module github.com/sil-org/traefik-https-proxy
 
 go 1.18
+
+require github.com/BurntSushi/toml v1.4.0